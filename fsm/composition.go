@@ -0,0 +1,252 @@
+package fsm
+
+import "fmt"
+
+// sameAlphabet reports whether f and other share exactly the same alphabet,
+// which the product construction requires.
+func (f *FSM) sameAlphabet(other *FSM) bool {
+	if len(f.alphabet) != len(other.alphabet) {
+		return false
+	}
+	for symbol := range f.alphabet {
+		if !other.alphabet[symbol] {
+			return false
+		}
+	}
+	return true
+}
+
+// pairName canonicalizes a pair of states from two DFAs into a single
+// composite state name for the product construction.
+func pairName(p, q State) State {
+	return State("(" + string(p) + "," + string(q) + ")")
+}
+
+// product runs the standard product construction over f and other, calling
+// isFinal to decide whether each reachable pair of states is accepting. It
+// requires the two machines to share an alphabet.
+func (f *FSM) product(other *FSM, isFinal func(p, q State) bool) (*FSM, error) {
+	if !f.sameAlphabet(other) {
+		return nil, fmt.Errorf("product construction requires matching alphabets")
+	}
+
+	start := pairName(f.initialState, other.initialState)
+	visited := map[State][2]State{start: {f.initialState, other.initialState}}
+	queue := []State{start}
+
+	builder := NewBuilder()
+	builder.AddStates(start)
+	for symbol := range f.alphabet {
+		builder.AddSymbols(symbol)
+	}
+
+	var finals []State
+	type transition struct {
+		from   State
+		symbol Symbol
+		to     State
+	}
+	var transitions []transition
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		pair := visited[name]
+		p, q := pair[0], pair[1]
+
+		if isFinal(p, q) {
+			finals = append(finals, name)
+		}
+
+		for symbol := range f.alphabet {
+			pNext, ok := f.transitions[TransitionKey{State: p, Symbol: symbol}]
+			if !ok {
+				return nil, fmt.Errorf("transition δ(%s, %s) is not defined", p, symbol)
+			}
+			qNext, ok := other.transitions[TransitionKey{State: q, Symbol: symbol}]
+			if !ok {
+				return nil, fmt.Errorf("transition δ(%s, %s) is not defined", q, symbol)
+			}
+
+			destName := pairName(pNext, qNext)
+			if _, seen := visited[destName]; !seen {
+				visited[destName] = [2]State{pNext, qNext}
+				builder.AddStates(destName)
+				queue = append(queue, destName)
+			}
+			transitions = append(transitions, transition{from: name, symbol: symbol, to: destName})
+		}
+	}
+
+	var err error
+	builder, err = builder.SetInitialState(start)
+	if err != nil {
+		return nil, fmt.Errorf("error setting initial state on product FSM: %w", err)
+	}
+	builder, err = builder.AddFinalStates(finals...)
+	if err != nil {
+		return nil, fmt.Errorf("error adding final states to product FSM: %w", err)
+	}
+	for _, tr := range transitions {
+		builder, err = builder.AddTransition(tr.from, tr.symbol, tr.to)
+		if err != nil {
+			return nil, fmt.Errorf("error adding transition to product FSM: %w", err)
+		}
+	}
+
+	// The product FSM may legitimately recognize the empty language — e.g.
+	// Intersect or Difference over two DFAs with disjoint languages — so it
+	// must not be rejected by Build's "at least one final state" invariant.
+	result, err := builder.buildAllowingEmptyLanguage()
+	if err != nil {
+		return nil, fmt.Errorf("error building product FSM: %w", err)
+	}
+	return result, nil
+}
+
+// Union returns a DFA recognizing the union of the languages of f and other.
+func (f *FSM) Union(other *FSM) (*FSM, error) {
+	result, err := f.product(other, func(p, q State) bool {
+		return f.finalStates[p] || other.finalStates[q]
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Union: %w", err)
+	}
+	return result, nil
+}
+
+// Intersect returns a DFA recognizing the intersection of the languages of
+// f and other.
+func (f *FSM) Intersect(other *FSM) (*FSM, error) {
+	result, err := f.product(other, func(p, q State) bool {
+		return f.finalStates[p] && other.finalStates[q]
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Intersect: %w", err)
+	}
+	return result, nil
+}
+
+// Difference returns a DFA recognizing strings accepted by f but not by
+// other.
+func (f *FSM) Difference(other *FSM) (*FSM, error) {
+	result, err := f.product(other, func(p, q State) bool {
+		return f.finalStates[p] && !other.finalStates[q]
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Difference: %w", err)
+	}
+	return result, nil
+}
+
+// Complement returns a DFA recognizing every string over f's alphabet that
+// f does not accept. Build already guarantees f's transition function is
+// total, so complementing only requires swapping final and non-final
+// states.
+func (f *FSM) Complement() (*FSM, error) {
+	builder := NewBuilder()
+	for state := range f.states {
+		builder.AddStates(state)
+	}
+	for symbol := range f.alphabet {
+		builder.AddSymbols(symbol)
+	}
+
+	var err error
+	builder, err = builder.SetInitialState(f.initialState)
+	if err != nil {
+		return nil, fmt.Errorf("Complement: error setting initial state: %w", err)
+	}
+
+	var finals []State
+	for state := range f.states {
+		if !f.finalStates[state] {
+			finals = append(finals, state)
+		}
+	}
+	builder, err = builder.AddFinalStates(finals...)
+	if err != nil {
+		return nil, fmt.Errorf("Complement: error adding final states: %w", err)
+	}
+
+	for key, dest := range f.transitions {
+		builder, err = builder.AddTransition(key.State, key.Symbol, dest)
+		if err != nil {
+			return nil, fmt.Errorf("Complement: error adding transition: %w", err)
+		}
+	}
+
+	// Complementing an FSM that accepts Σ* (every state final) yields an
+	// FSM with no final states at all, recognizing ∅ — a legitimate result
+	// that Build's "at least one final state" invariant would reject.
+	result, err := builder.buildAllowingEmptyLanguage()
+	if err != nil {
+		return nil, fmt.Errorf("Complement: error building FSM: %w", err)
+	}
+	return result, nil
+}
+
+// Equivalent reports whether f and other recognize the same language. It
+// checks that the symmetric difference (f \ other) ∪ (other \ f) accepts
+// the empty language, i.e. that no pair of states reachable by the product
+// construction has a final state on exactly one side. This is computed
+// directly over the product's reachable pairs rather than by materializing
+// an FSM for the symmetric difference, and the search stops at the first
+// such pair.
+func (f *FSM) Equivalent(other *FSM) (bool, error) {
+	fOnly, err := f.productAccepts(other, func(p, q State) bool {
+		return f.finalStates[p] && !other.finalStates[q]
+	})
+	if err != nil {
+		return false, fmt.Errorf("Equivalent: %w", err)
+	}
+	otherOnly, err := other.productAccepts(f, func(p, q State) bool {
+		return other.finalStates[p] && !f.finalStates[q]
+	})
+	if err != nil {
+		return false, fmt.Errorf("Equivalent: %w", err)
+	}
+
+	return !fOnly && !otherOnly, nil
+}
+
+// productAccepts reports whether any pair of states reachable by the
+// product construction over f and other satisfies isFinal, without
+// materializing the product as an FSM.
+func (f *FSM) productAccepts(other *FSM, isFinal func(p, q State) bool) (bool, error) {
+	if !f.sameAlphabet(other) {
+		return false, fmt.Errorf("product construction requires matching alphabets")
+	}
+
+	type pair struct{ p, q State }
+	start := pair{f.initialState, other.initialState}
+	visited := map[pair]bool{start: true}
+	queue := []pair{start}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		if isFinal(cur.p, cur.q) {
+			return true, nil
+		}
+
+		for symbol := range f.alphabet {
+			pNext, ok := f.transitions[TransitionKey{State: cur.p, Symbol: symbol}]
+			if !ok {
+				return false, fmt.Errorf("transition δ(%s, %s) is not defined", cur.p, symbol)
+			}
+			qNext, ok := other.transitions[TransitionKey{State: cur.q, Symbol: symbol}]
+			if !ok {
+				return false, fmt.Errorf("transition δ(%s, %s) is not defined", cur.q, symbol)
+			}
+
+			next := pair{pNext, qNext}
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,314 @@
+package fsm
+
+import "testing"
+
+// buildEndsInA builds a DFA over {a,b} accepting strings ending in "a".
+func buildEndsInA(t *testing.T) *FSM {
+	t.Helper()
+
+	builder := NewBuilder().AddStates("s0", "s1").AddSymbols("a", "b")
+	builder, err := builder.SetInitialState("s0")
+	if err != nil {
+		t.Fatalf("SetInitialState: %v", err)
+	}
+	builder, err = builder.AddFinalStates("s1")
+	if err != nil {
+		t.Fatalf("AddFinalStates: %v", err)
+	}
+	transitions := []map[TransitionKey]State{
+		{{State: "s0", Symbol: "a"}: "s1"},
+		{{State: "s0", Symbol: "b"}: "s0"},
+		{{State: "s1", Symbol: "a"}: "s1"},
+		{{State: "s1", Symbol: "b"}: "s0"},
+	}
+	builder, err = builder.AddTransitions(transitions)
+	if err != nil {
+		t.Fatalf("AddTransitions: %v", err)
+	}
+	machine, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	return machine
+}
+
+// buildEvenLength builds a DFA over {a,b} accepting strings of even length.
+func buildEvenLength(t *testing.T) *FSM {
+	t.Helper()
+
+	builder := NewBuilder().AddStates("e0", "e1").AddSymbols("a", "b")
+	builder, err := builder.SetInitialState("e0")
+	if err != nil {
+		t.Fatalf("SetInitialState: %v", err)
+	}
+	builder, err = builder.AddFinalStates("e0")
+	if err != nil {
+		t.Fatalf("AddFinalStates: %v", err)
+	}
+	transitions := []map[TransitionKey]State{
+		{{State: "e0", Symbol: "a"}: "e1"},
+		{{State: "e0", Symbol: "b"}: "e1"},
+		{{State: "e1", Symbol: "a"}: "e0"},
+		{{State: "e1", Symbol: "b"}: "e0"},
+	}
+	builder, err = builder.AddTransitions(transitions)
+	if err != nil {
+		t.Fatalf("AddTransitions: %v", err)
+	}
+	machine, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	return machine
+}
+
+func TestUnion(t *testing.T) {
+	endsInA := buildEndsInA(t)
+	evenLength := buildEvenLength(t)
+
+	union, err := endsInA.Union(evenLength)
+	if err != nil {
+		t.Fatalf("Union: %v", err)
+	}
+
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"a", true},    // ends in a
+		{"ab", true},   // even length
+		{"b", false},   // neither
+		{"bbb", false}, // odd length, ends in b
+	}
+	for _, tc := range testCases {
+		accepted, err := union.ProcessInput(tc.input)
+		if err != nil {
+			t.Errorf("ProcessInput(%q) returned error: %v", tc.input, err)
+			continue
+		}
+		if accepted != tc.expected {
+			t.Errorf("Union.ProcessInput(%q) = %v, expected %v", tc.input, accepted, tc.expected)
+		}
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	endsInA := buildEndsInA(t)
+	evenLength := buildEvenLength(t)
+
+	intersection, err := endsInA.Intersect(evenLength)
+	if err != nil {
+		t.Fatalf("Intersect: %v", err)
+	}
+
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"ba", true},  // ends in a, even length
+		{"a", false},  // ends in a, odd length
+		{"ab", false}, // even length, ends in b
+		{"bb", false}, // even length, ends in b
+	}
+	for _, tc := range testCases {
+		accepted, err := intersection.ProcessInput(tc.input)
+		if err != nil {
+			t.Errorf("ProcessInput(%q) returned error: %v", tc.input, err)
+			continue
+		}
+		if accepted != tc.expected {
+			t.Errorf("Intersect.ProcessInput(%q) = %v, expected %v", tc.input, accepted, tc.expected)
+		}
+	}
+}
+
+func TestDifference(t *testing.T) {
+	endsInA := buildEndsInA(t)
+	evenLength := buildEvenLength(t)
+
+	diff, err := endsInA.Difference(evenLength)
+	if err != nil {
+		t.Fatalf("Difference: %v", err)
+	}
+
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"a", true},   // ends in a, but not even length
+		{"ba", false}, // ends in a, but even length (excluded)
+		{"b", false},  // does not end in a
+	}
+	for _, tc := range testCases {
+		accepted, err := diff.ProcessInput(tc.input)
+		if err != nil {
+			t.Errorf("ProcessInput(%q) returned error: %v", tc.input, err)
+			continue
+		}
+		if accepted != tc.expected {
+			t.Errorf("Difference.ProcessInput(%q) = %v, expected %v", tc.input, accepted, tc.expected)
+		}
+	}
+}
+
+func TestComplement(t *testing.T) {
+	endsInA := buildEndsInA(t)
+	complement, err := endsInA.Complement()
+	if err != nil {
+		t.Fatalf("Complement: %v", err)
+	}
+
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"a", false},
+		{"b", true},
+		{"ab", true},
+		{"ba", false},
+	}
+	for _, tc := range testCases {
+		accepted, err := complement.ProcessInput(tc.input)
+		if err != nil {
+			t.Errorf("ProcessInput(%q) returned error: %v", tc.input, err)
+			continue
+		}
+		if accepted != tc.expected {
+			t.Errorf("Complement.ProcessInput(%q) = %v, expected %v", tc.input, accepted, tc.expected)
+		}
+	}
+}
+
+func TestComplementOfAcceptsAllIsEmptyLanguage(t *testing.T) {
+	// Every state of the mod-3 FSM is final, so it accepts Σ* and its
+	// complement recognizes ∅ — a DFA with no final states at all, which
+	// must still build successfully.
+	machine, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	complement, err := machine.Complement()
+	if err != nil {
+		t.Fatalf("Complement: %v", err)
+	}
+	if got, want := len(complement.GetFinalStates()), 0; got != want {
+		t.Errorf("Complement() produced %d final states, expected %d", got, want)
+	}
+
+	accepted, err := complement.ProcessInput("1101")
+	if err != nil {
+		t.Fatalf("ProcessInput: %v", err)
+	}
+	if accepted {
+		t.Error("expected the complement of an accepts-everything FSM to accept nothing")
+	}
+}
+
+func TestIntersectDisjointLanguagesIsEmptyLanguage(t *testing.T) {
+	endsInA := buildEndsInA(t)
+
+	builder := NewBuilder().AddStates("n0", "n1").AddSymbols("a", "b")
+	builder, err := builder.SetInitialState("n0")
+	if err != nil {
+		t.Fatalf("SetInitialState: %v", err)
+	}
+	builder, err = builder.AddFinalStates("n1")
+	if err != nil {
+		t.Fatalf("AddFinalStates: %v", err)
+	}
+	transitions := []map[TransitionKey]State{
+		{{State: "n0", Symbol: "a"}: "n0"},
+		{{State: "n0", Symbol: "b"}: "n1"},
+		{{State: "n1", Symbol: "a"}: "n0"},
+		{{State: "n1", Symbol: "b"}: "n1"},
+	}
+	builder, err = builder.AddTransitions(transitions)
+	if err != nil {
+		t.Fatalf("AddTransitions: %v", err)
+	}
+	endsInB, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	// endsInA accepts only strings ending in "a"; endsInB accepts only
+	// strings ending in "b" — their languages are disjoint, so the
+	// intersection recognizes ∅.
+	intersection, err := endsInA.Intersect(endsInB)
+	if err != nil {
+		t.Fatalf("Intersect: %v", err)
+	}
+	if got, want := len(intersection.GetFinalStates()), 0; got != want {
+		t.Errorf("Intersect() produced %d final states, expected %d", got, want)
+	}
+
+	for _, input := range []string{"a", "b", "aab", "bba"} {
+		accepted, err := intersection.ProcessInput(input)
+		if err != nil {
+			t.Fatalf("ProcessInput(%q): %v", input, err)
+		}
+		if accepted {
+			t.Errorf("ProcessInput(%q) = true, expected false for the empty language", input)
+		}
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	endsInA := buildEndsInA(t)
+
+	same, err := endsInA.Equivalent(endsInA)
+	if err != nil {
+		t.Fatalf("Equivalent: %v", err)
+	}
+	if !same {
+		t.Error("expected an FSM to be equivalent to itself")
+	}
+
+	evenLength := buildEvenLength(t)
+	different, err := endsInA.Equivalent(evenLength)
+	if err != nil {
+		t.Fatalf("Equivalent: %v", err)
+	}
+	if different {
+		t.Error("expected endsInA and evenLength to not be equivalent")
+	}
+
+	minimized, err := endsInA.Minimize()
+	if err != nil {
+		t.Fatalf("Minimize: %v", err)
+	}
+	equivToMinimized, err := endsInA.Equivalent(minimized)
+	if err != nil {
+		t.Fatalf("Equivalent: %v", err)
+	}
+	if !equivToMinimized {
+		t.Error("expected an FSM to be equivalent to its minimized form")
+	}
+}
+
+func TestUnionMismatchedAlphabets(t *testing.T) {
+	a := buildEndsInA(t)
+
+	builder := NewBuilder().AddStates("z0").AddSymbols("x")
+	builder, err := builder.SetInitialState("z0")
+	if err != nil {
+		t.Fatalf("SetInitialState: %v", err)
+	}
+	builder, err = builder.AddFinalStates("z0")
+	if err != nil {
+		t.Fatalf("AddFinalStates: %v", err)
+	}
+	builder, err = builder.AddTransition("z0", "x", "z0")
+	if err != nil {
+		t.Fatalf("AddTransition: %v", err)
+	}
+	other, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	if _, err := a.Union(other); err == nil {
+		t.Error("expected error for mismatched alphabets, got none")
+	}
+}
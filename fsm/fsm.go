@@ -1,6 +1,9 @@
 package fsm
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // State represents a state in the finite automaton
 type State string
@@ -22,6 +25,10 @@ type FSM struct {
 	finalStates  map[State]bool          // F: set of accepting/final states
 	transitions  map[TransitionKey]State // δ: transition function Q×Σ→Q
 	currentState State                   // current state during execution
+
+	onEnter      map[State][]EnterExitHook // hooks fired on entering a state, keyed by state, in registration order
+	onExit       map[State][]EnterExitHook // hooks fired on exiting a state, keyed by state, in registration order
+	onTransition []TransitionHook          // hooks fired on every transition, in registration order
 }
 
 // Builder provides a fluent interface for building FSMs
@@ -121,6 +128,36 @@ func (b *Builder) Build() (*FSM, error) {
 		return nil, fmt.Errorf("FSM must have at least one final state")
 	}
 
+	return b.finishBuild()
+}
+
+// buildAllowingEmptyLanguage runs the same validation as Build except for
+// the "at least one final state" check. It exists for internal callers
+// (Minimize, the product construction, Complement) whose result may
+// legitimately recognize the empty language — e.g. complementing an FSM
+// that accepts Σ*, or intersecting two FSMs with disjoint languages — cases
+// where Build's invariant would incorrectly reject an otherwise valid FSM.
+// Build keeps enforcing the stricter invariant for hand-assembled FSMs,
+// since a builder call chain that never names a final state is almost
+// always a mistake, not a deliberate empty language.
+func (b *Builder) buildAllowingEmptyLanguage() (*FSM, error) {
+	if len(b.fsm.states) == 0 {
+		return nil, fmt.Errorf("FSM must have at least one state")
+	}
+	if len(b.fsm.alphabet) == 0 {
+		return nil, fmt.Errorf("FSM must have at least one symbol in alphabet")
+	}
+	if b.fsm.initialState == "" {
+		return nil, fmt.Errorf("FSM must have an initial state")
+	}
+
+	return b.finishBuild()
+}
+
+// finishBuild validates what Build and buildAllowingEmptyLanguage share
+// beyond their own state/alphabet/initial-state/final-state checks: that
+// the initial state is a known state and the transition function is total.
+func (b *Builder) finishBuild() (*FSM, error) {
 	if !b.fsm.states[b.fsm.initialState] {
 		return nil, fmt.Errorf("initial state must be in state set")
 	}
@@ -149,18 +186,7 @@ func (f *FSM) CurrentState() State {
 }
 
 func (f *FSM) step(symbol Symbol) error {
-	if !f.alphabet[symbol] {
-		return fmt.Errorf("symbol %s not in alphabet", symbol)
-	}
-
-	key := TransitionKey{State: f.currentState, Symbol: symbol}
-	nextState, exists := f.transitions[key]
-	if !exists {
-		return fmt.Errorf("no transition defined for δ(%s, %s)", f.currentState, symbol)
-	}
-
-	f.currentState = nextState
-	return nil
+	return f.StepCtx(context.Background(), symbol, nil)
 }
 
 func (f *FSM) ProcessString(input string) error {
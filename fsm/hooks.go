@@ -0,0 +1,82 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+)
+
+// EnterExitHook is called when the FSM enters or exits a state. args is
+// whatever the caller passed to StepCtx.
+type EnterExitHook func(ctx context.Context, from State, sym Symbol, args any)
+
+// TransitionHook is called on every transition, regardless of the states
+// involved. args is whatever the caller passed to StepCtx.
+type TransitionHook func(ctx context.Context, from State, sym Symbol, to State, args any)
+
+// RegisterOnEnter registers fn to fire whenever the FSM enters state, in
+// registration order relative to other hooks registered for that state.
+func (f *FSM) RegisterOnEnter(state State, fn EnterExitHook) {
+	if f.onEnter == nil {
+		f.onEnter = make(map[State][]EnterExitHook)
+	}
+	f.onEnter[state] = append(f.onEnter[state], fn)
+}
+
+// RegisterOnExit registers fn to fire whenever the FSM exits state, in
+// registration order relative to other hooks registered for that state.
+func (f *FSM) RegisterOnExit(state State, fn EnterExitHook) {
+	if f.onExit == nil {
+		f.onExit = make(map[State][]EnterExitHook)
+	}
+	f.onExit[state] = append(f.onExit[state], fn)
+}
+
+// RegisterOnTransition registers fn to fire on every transition, in
+// registration order relative to other transition hooks.
+func (f *FSM) RegisterOnTransition(fn TransitionHook) {
+	f.onTransition = append(f.onTransition, fn)
+}
+
+// StepCtx advances the FSM by sym, firing exit hooks for the current state,
+// then transition hooks, then entry hooks for the destination state, in
+// that order and in deterministic registration order within each group.
+// Hooks may abort further processing by canceling ctx; StepCtx checks
+// ctx.Err() after each hook and returns it immediately if set.
+func (f *FSM) StepCtx(ctx context.Context, sym Symbol, args any) error {
+	if !f.alphabet[sym] {
+		return fmt.Errorf("symbol %s not in alphabet", sym)
+	}
+
+	key := TransitionKey{State: f.currentState, Symbol: sym}
+	nextState, exists := f.transitions[key]
+	if !exists {
+		return fmt.Errorf("no transition defined for δ(%s, %s)", f.currentState, sym)
+	}
+
+	from := f.currentState
+
+	for _, hook := range f.onExit[from] {
+		hook(ctx, from, sym, args)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	for _, hook := range f.onTransition {
+		hook(ctx, from, sym, nextState, args)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	f.currentState = nextState
+
+	for _, hook := range f.onEnter[nextState] {
+		hook(ctx, from, sym, args)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestHooksFireInOrder(t *testing.T) {
+	machine, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	var order []string
+	machine.RegisterOnExit("s0", func(ctx context.Context, from State, sym Symbol, args any) {
+		order = append(order, "exit:s0")
+	})
+	machine.RegisterOnTransition(func(ctx context.Context, from State, sym Symbol, to State, args any) {
+		order = append(order, "transition")
+	})
+	machine.RegisterOnEnter("s1", func(ctx context.Context, from State, sym Symbol, args any) {
+		order = append(order, "enter:s1")
+	})
+
+	if err := machine.StepCtx(context.Background(), "1", nil); err != nil {
+		t.Fatalf("StepCtx: %v", err)
+	}
+
+	expected := []string{"exit:s0", "transition", "enter:s1"}
+	if len(order) != len(expected) {
+		t.Fatalf("expected hook order %v, got %v", expected, order)
+	}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Errorf("expected hook order %v, got %v", expected, order)
+			break
+		}
+	}
+}
+
+func TestHooksRegistrationOrder(t *testing.T) {
+	machine, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	var order []int
+	machine.RegisterOnEnter("s1", func(ctx context.Context, from State, sym Symbol, args any) {
+		order = append(order, 1)
+	})
+	machine.RegisterOnEnter("s1", func(ctx context.Context, from State, sym Symbol, args any) {
+		order = append(order, 2)
+	})
+
+	if err := machine.StepCtx(context.Background(), "1", nil); err != nil {
+		t.Fatalf("StepCtx: %v", err)
+	}
+
+	if len(order) != 2 || order[0] != 1 || order[1] != 2 {
+		t.Errorf("expected hooks to fire in registration order [1 2], got %v", order)
+	}
+}
+
+func TestHooksCancellationAbortsProcessing(t *testing.T) {
+	machine, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	machine.RegisterOnTransition(func(ctx context.Context, from State, sym Symbol, to State, args any) {
+		cancel()
+	})
+
+	entered := false
+	machine.RegisterOnEnter("s1", func(ctx context.Context, from State, sym Symbol, args any) {
+		entered = true
+	})
+
+	err = machine.StepCtx(ctx, "1", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if entered {
+		t.Error("expected entry hook to be skipped once ctx was canceled")
+	}
+}
+
+func TestHooksReceiveArgs(t *testing.T) {
+	machine, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	var received any
+	machine.RegisterOnTransition(func(ctx context.Context, from State, sym Symbol, to State, args any) {
+		received = args
+	})
+
+	if err := machine.StepCtx(context.Background(), "1", "payload"); err != nil {
+		t.Fatalf("StepCtx: %v", err)
+	}
+	if received != "payload" {
+		t.Errorf("expected hook to receive args %q, got %v", "payload", received)
+	}
+}
+
+func TestStepStillFiresHooks(t *testing.T) {
+	machine, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	fired := false
+	machine.RegisterOnTransition(func(ctx context.Context, from State, sym Symbol, to State, args any) {
+		fired = true
+	})
+
+	if _, err := machine.ProcessInput("1"); err != nil {
+		t.Fatalf("ProcessInput: %v", err)
+	}
+	if !fired {
+		t.Error("expected ProcessInput to fire registered hooks via the shared step path")
+	}
+}
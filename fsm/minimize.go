@@ -0,0 +1,241 @@
+package fsm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Minimize returns a language-equivalent DFA with the minimum number of
+// states, computed via Hopcroft's partition-refinement algorithm. Unreachable
+// states are pruned first via BFS from the initial state, since they play no
+// part in the language and would otherwise pollute the partition.
+func (f *FSM) Minimize() (*FSM, error) {
+	reachable := f.reachableStates()
+
+	nonFinal := make(map[State]bool)
+	final := make(map[State]bool)
+	for state := range reachable {
+		if f.finalStates[state] {
+			final[state] = true
+		} else {
+			nonFinal[state] = true
+		}
+	}
+
+	partition := []map[State]bool{}
+	if len(final) > 0 {
+		partition = append(partition, final)
+	}
+	if len(nonFinal) > 0 {
+		partition = append(partition, nonFinal)
+	}
+
+	var worklist []map[State]bool
+	if len(final) > 0 && len(nonFinal) > 0 {
+		if len(final) <= len(nonFinal) {
+			worklist = append(worklist, final)
+		} else {
+			worklist = append(worklist, nonFinal)
+		}
+	} else if len(final) > 0 {
+		worklist = append(worklist, final)
+	} else {
+		worklist = append(worklist, nonFinal)
+	}
+
+	// predecessors[symbol][state] is the set of states with a transition on
+	// symbol into state, so splitting by a set A only requires a lookup
+	// rather than a scan of every transition.
+	predecessors := make(map[Symbol]map[State]map[State]bool)
+	for symbol := range f.alphabet {
+		predecessors[symbol] = make(map[State]map[State]bool)
+	}
+	for key, dest := range f.transitions {
+		if !reachable[key.State] || !reachable[dest] {
+			continue
+		}
+		if predecessors[key.Symbol][dest] == nil {
+			predecessors[key.Symbol][dest] = make(map[State]bool)
+		}
+		predecessors[key.Symbol][dest][key.State] = true
+	}
+
+	for len(worklist) > 0 {
+		a := worklist[0]
+		worklist = worklist[1:]
+
+		for symbol := range f.alphabet {
+			x := make(map[State]bool)
+			for state := range a {
+				for pred := range predecessors[symbol][state] {
+					x[pred] = true
+				}
+			}
+			if len(x) == 0 {
+				continue
+			}
+
+			var refined []map[State]bool
+			for _, y := range partition {
+				intersect := make(map[State]bool)
+				diff := make(map[State]bool)
+				for state := range y {
+					if x[state] {
+						intersect[state] = true
+					} else {
+						diff[state] = true
+					}
+				}
+				if len(intersect) == 0 || len(diff) == 0 {
+					refined = append(refined, y)
+					continue
+				}
+
+				refined = append(refined, intersect, diff)
+
+				replaced := false
+				for i, w := range worklist {
+					if sameSet(w, y) {
+						worklist[i] = intersect
+						worklist = append(worklist, diff)
+						replaced = true
+						break
+					}
+				}
+				if !replaced {
+					if len(intersect) <= len(diff) {
+						worklist = append(worklist, intersect)
+					} else {
+						worklist = append(worklist, diff)
+					}
+				}
+			}
+			partition = refined
+		}
+	}
+
+	return f.buildFromPartition(partition)
+}
+
+// reachableStates returns the set of states reachable from the initial
+// state via BFS over the transition function.
+func (f *FSM) reachableStates() map[State]bool {
+	reachable := map[State]bool{f.initialState: true}
+	queue := []State{f.initialState}
+
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for symbol := range f.alphabet {
+			dest, ok := f.transitions[TransitionKey{State: state, Symbol: symbol}]
+			if !ok || reachable[dest] {
+				continue
+			}
+			reachable[dest] = true
+			queue = append(queue, dest)
+		}
+	}
+	return reachable
+}
+
+// sameSet reports whether two state sets contain exactly the same states.
+func sameSet(a, b map[State]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for state := range a {
+		if !b[state] {
+			return false
+		}
+	}
+	return true
+}
+
+// blockName canonicalizes a partition block into a single state name by
+// sorting its member names and joining them, mirroring subsetName.
+func blockName(block map[State]bool) State {
+	names := make([]string, 0, len(block))
+	for state := range block {
+		names = append(names, string(state))
+	}
+	sort.Strings(names)
+	return State("[" + strings.Join(names, ",") + "]")
+}
+
+// buildFromPartition lifts a partition of equivalence blocks into a new
+// minimized FSM: each block becomes one state, with transitions defined
+// through the block-representative map and finals defined as blocks
+// containing any original final state.
+func (f *FSM) buildFromPartition(partition []map[State]bool) (*FSM, error) {
+	blockOf := make(map[State]State)
+	for _, block := range partition {
+		name := blockName(block)
+		for state := range block {
+			blockOf[state] = name
+		}
+	}
+
+	builder := NewBuilder()
+	for _, block := range partition {
+		builder.AddStates(blockName(block))
+	}
+	for symbol := range f.alphabet {
+		builder.AddSymbols(symbol)
+	}
+
+	var err error
+	builder, err = builder.SetInitialState(blockOf[f.initialState])
+	if err != nil {
+		return nil, fmt.Errorf("error setting initial state on minimized FSM: %w", err)
+	}
+
+	var finals []State
+	for _, block := range partition {
+		for state := range block {
+			if f.finalStates[state] {
+				finals = append(finals, blockName(block))
+				break
+			}
+		}
+	}
+	builder, err = builder.AddFinalStates(finals...)
+	if err != nil {
+		return nil, fmt.Errorf("error adding final states to minimized FSM: %w", err)
+	}
+
+	seen := make(map[TransitionKey]bool)
+	for _, block := range partition {
+		name := blockName(block)
+		var representative State
+		for state := range block {
+			representative = state
+			break
+		}
+		for symbol := range f.alphabet {
+			dest, ok := f.transitions[TransitionKey{State: representative, Symbol: symbol}]
+			if !ok {
+				return nil, fmt.Errorf("transition δ(%s, %s) is not defined", representative, symbol)
+			}
+			key := TransitionKey{State: name, Symbol: symbol}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			builder, err = builder.AddTransition(name, symbol, blockOf[dest])
+			if err != nil {
+				return nil, fmt.Errorf("error adding transition to minimized FSM: %w", err)
+			}
+		}
+	}
+
+	// The minimized FSM may legitimately have no final states at all — e.g.
+	// when the only final state in f is unreachable and gets pruned before
+	// partitioning — so it must not be rejected by Build's "at least one
+	// final state" invariant.
+	minimized, err := builder.buildAllowingEmptyLanguage()
+	if err != nil {
+		return nil, fmt.Errorf("error building minimized FSM: %w", err)
+	}
+	return minimized, nil
+}
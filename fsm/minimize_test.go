@@ -0,0 +1,150 @@
+package fsm
+
+import "testing"
+
+// buildRedundantFSM builds a DFA over {0,1} accepting strings with an even
+// number of 0s, using two equivalent copies of each state (q0/q2 and q1/q3)
+// so that Minimize has real work to do.
+func buildRedundantFSM(t *testing.T) *FSM {
+	t.Helper()
+
+	builder := NewBuilder().
+		AddStates("q0", "q1", "q2", "q3").
+		AddSymbols("0", "1")
+
+	builder, err := builder.SetInitialState("q0")
+	if err != nil {
+		t.Fatalf("SetInitialState: %v", err)
+	}
+	builder, err = builder.AddFinalStates("q0", "q2")
+	if err != nil {
+		t.Fatalf("AddFinalStates: %v", err)
+	}
+
+	transitions := []map[TransitionKey]State{
+		{{State: "q0", Symbol: "0"}: "q1"},
+		{{State: "q0", Symbol: "1"}: "q2"},
+		{{State: "q1", Symbol: "0"}: "q0"},
+		{{State: "q1", Symbol: "1"}: "q3"},
+		{{State: "q2", Symbol: "0"}: "q3"},
+		{{State: "q2", Symbol: "1"}: "q0"},
+		{{State: "q3", Symbol: "0"}: "q2"},
+		{{State: "q3", Symbol: "1"}: "q1"},
+	}
+	builder, err = builder.AddTransitions(transitions)
+	if err != nil {
+		t.Fatalf("AddTransitions: %v", err)
+	}
+
+	machine, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	return machine
+}
+
+func TestMinimizePreservesLanguage(t *testing.T) {
+	machine := buildRedundantFSM(t)
+	minimized, err := machine.Minimize()
+	if err != nil {
+		t.Fatalf("Minimize: %v", err)
+	}
+
+	testCases := []string{"", "0", "1", "00", "01", "10", "11", "000", "0101", "111000"}
+	for _, input := range testCases {
+		want, err := machine.ProcessInput(input)
+		if err != nil {
+			t.Fatalf("ProcessInput(%q) on original: %v", input, err)
+		}
+		got, err := minimized.ProcessInput(input)
+		if err != nil {
+			t.Fatalf("ProcessInput(%q) on minimized: %v", input, err)
+		}
+		if got != want {
+			t.Errorf("minimized.ProcessInput(%q) = %v, want %v (original)", input, got, want)
+		}
+	}
+}
+
+func TestMinimizeReducesStateCount(t *testing.T) {
+	machine := buildRedundantFSM(t)
+	minimized, err := machine.Minimize()
+	if err != nil {
+		t.Fatalf("Minimize: %v", err)
+	}
+
+	if got, want := len(minimized.GetStates()), 2; got != want {
+		t.Errorf("Minimize() produced %d states, expected %d", got, want)
+	}
+}
+
+func TestMinimizeAllStatesFinal(t *testing.T) {
+	// Every state of the mod-3 FSM is final, so the language it recognizes
+	// is Σ*: the minimal DFA for that language has exactly one state.
+	machine, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	minimized, err := machine.Minimize()
+	if err != nil {
+		t.Fatalf("Minimize: %v", err)
+	}
+	if got, want := len(minimized.GetStates()), 1; got != want {
+		t.Errorf("Minimize() produced %d states, expected %d", got, want)
+	}
+
+	accepted, err := minimized.ProcessInput("1101")
+	if err != nil {
+		t.Fatalf("ProcessInput: %v", err)
+	}
+	if !accepted {
+		t.Error("expected every input to be accepted")
+	}
+}
+
+func TestMinimizeUnreachableFinalStateYieldsEmptyLanguage(t *testing.T) {
+	// s1 is final but unreachable from the initial state s0, so the
+	// language f recognizes is ∅. Minimize must still succeed and return a
+	// DFA with no final states rather than erroring on the "at least one
+	// final state" invariant that only applies to hand-assembled FSMs.
+	builder := NewBuilder().AddStates("s0", "s1").AddSymbols("a")
+	builder, err := builder.SetInitialState("s0")
+	if err != nil {
+		t.Fatalf("SetInitialState: %v", err)
+	}
+	builder, err = builder.AddFinalStates("s1")
+	if err != nil {
+		t.Fatalf("AddFinalStates: %v", err)
+	}
+	builder, err = builder.AddTransition("s0", "a", "s0")
+	if err != nil {
+		t.Fatalf("AddTransition: %v", err)
+	}
+	builder, err = builder.AddTransition("s1", "a", "s1")
+	if err != nil {
+		t.Fatalf("AddTransition: %v", err)
+	}
+	machine, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	minimized, err := machine.Minimize()
+	if err != nil {
+		t.Fatalf("Minimize: %v", err)
+	}
+	if got, want := len(minimized.GetFinalStates()), 0; got != want {
+		t.Errorf("Minimize() produced %d final states, expected %d", got, want)
+	}
+
+	for _, input := range []string{"", "a", "aaa"} {
+		accepted, err := minimized.ProcessInput(input)
+		if err != nil {
+			t.Fatalf("ProcessInput(%q): %v", input, err)
+		}
+		if accepted {
+			t.Errorf("ProcessInput(%q) = true, expected false for the empty language", input)
+		}
+	}
+}
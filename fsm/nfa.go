@@ -0,0 +1,356 @@
+package fsm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// epsilon is the empty symbol that an ε-move stands for. ε-moves are stored
+// apart from symbol transitions, in NFA.epsilon, so no transition is keyed
+// by this symbol; NFABuilder.AddSymbols does not reject it.
+const epsilon Symbol = ""
+
+// NFA represents a nondeterministic finite automaton (Q, Σ, q0, F, δ) where δ
+// may map a (state, symbol) pair to several destination states and states may
+// additionally be connected by ε-transitions that consume no input.
+type NFA struct {
+	states       map[State]bool
+	alphabet     map[Symbol]bool
+	initialState State
+	finalStates  map[State]bool
+	transitions  map[TransitionKey]map[State]bool // δ: transition relation Q×Σ→2^Q
+	epsilon      map[State]map[State]bool         // ε-moves: state -> set of states reachable via ε
+	frontier     map[State]bool                   // current set of active states during execution
+}
+
+// NFABuilder provides a fluent interface for building NFAs, mirroring Builder.
+type NFABuilder struct {
+	nfa *NFA
+}
+
+// NewNFABuilder creates a new NFA builder.
+func NewNFABuilder() *NFABuilder {
+	return &NFABuilder{
+		nfa: &NFA{
+			states:      make(map[State]bool),
+			alphabet:    make(map[Symbol]bool),
+			finalStates: make(map[State]bool),
+			transitions: make(map[TransitionKey]map[State]bool),
+			epsilon:     make(map[State]map[State]bool),
+		},
+	}
+}
+
+// AddStates adds multiple states to the NFA.
+func (b *NFABuilder) AddStates(states ...State) *NFABuilder {
+	for _, state := range states {
+		b.nfa.states[state] = true
+	}
+	return b
+}
+
+// AddSymbols adds multiple symbols to the NFA's alphabet.
+func (b *NFABuilder) AddSymbols(symbols ...Symbol) *NFABuilder {
+	for _, symbol := range symbols {
+		b.nfa.alphabet[symbol] = true
+	}
+	return b
+}
+
+// SetInitialState designates the NFA's initial state.
+func (b *NFABuilder) SetInitialState(state State) (*NFABuilder, error) {
+	if !b.nfa.states[state] {
+		return b, fmt.Errorf("state %s not in state set", state)
+	}
+	b.nfa.initialState = state
+	return b, nil
+}
+
+// AddFinalStates marks the given states as accepting.
+func (b *NFABuilder) AddFinalStates(states ...State) (*NFABuilder, error) {
+	for _, state := range states {
+		if !b.nfa.states[state] {
+			return b, fmt.Errorf("state %s not in state set", state)
+		}
+		b.nfa.finalStates[state] = true
+	}
+	return b, nil
+}
+
+// AddTransition records that δ(state, symbol) includes nextState. Unlike the
+// DFA Builder, calling this multiple times for the same (state, symbol) pair
+// is allowed and accumulates destinations.
+func (b *NFABuilder) AddTransition(state State, symbol Symbol, nextState State) (*NFABuilder, error) {
+	if !b.nfa.states[state] {
+		return b, fmt.Errorf("state %s not in state set", state)
+	}
+	if !b.nfa.states[nextState] {
+		return b, fmt.Errorf("next state %s not in state set", nextState)
+	}
+	if !b.nfa.alphabet[symbol] {
+		return b, fmt.Errorf("symbol %s not in alphabet", symbol)
+	}
+
+	key := TransitionKey{State: state, Symbol: symbol}
+	if b.nfa.transitions[key] == nil {
+		b.nfa.transitions[key] = make(map[State]bool)
+	}
+	b.nfa.transitions[key][nextState] = true
+	return b, nil
+}
+
+// AddEpsilonTransition records an ε-move from "from" to "to": the NFA may
+// move to "to" without consuming any input.
+func (b *NFABuilder) AddEpsilonTransition(from, to State) (*NFABuilder, error) {
+	if !b.nfa.states[from] {
+		return b, fmt.Errorf("state %s not in state set", from)
+	}
+	if !b.nfa.states[to] {
+		return b, fmt.Errorf("state %s not in state set", to)
+	}
+
+	if b.nfa.epsilon[from] == nil {
+		b.nfa.epsilon[from] = make(map[State]bool)
+	}
+	b.nfa.epsilon[from][to] = true
+	return b, nil
+}
+
+// Build validates the NFA and returns it. Unlike the DFA Builder, the
+// transition relation is not required to be total.
+func (b *NFABuilder) Build() (*NFA, error) {
+	if len(b.nfa.states) == 0 {
+		return nil, fmt.Errorf("NFA must have at least one state")
+	}
+	if len(b.nfa.alphabet) == 0 {
+		return nil, fmt.Errorf("NFA must have at least one symbol in alphabet")
+	}
+	if b.nfa.initialState == "" {
+		return nil, fmt.Errorf("NFA must have an initial state")
+	}
+	if !b.nfa.states[b.nfa.initialState] {
+		return nil, fmt.Errorf("initial state must be in state set")
+	}
+	if len(b.nfa.finalStates) == 0 {
+		return nil, fmt.Errorf("NFA must have at least one final state")
+	}
+
+	b.nfa.frontier = b.nfa.epsilonClosure(map[State]bool{b.nfa.initialState: true})
+	return b.nfa, nil
+}
+
+// Reset returns the NFA to the ε-closure of its initial state.
+func (n *NFA) Reset() {
+	n.frontier = n.epsilonClosure(map[State]bool{n.initialState: true})
+}
+
+// CurrentStates returns the set of states the NFA is currently in.
+func (n *NFA) CurrentStates() []State {
+	states := make([]State, 0, len(n.frontier))
+	for state := range n.frontier {
+		states = append(states, state)
+	}
+	return states
+}
+
+// epsilonClosure returns the set of states reachable from any state in
+// "from" using zero or more ε-transitions, including the states in "from".
+func (n *NFA) epsilonClosure(from map[State]bool) map[State]bool {
+	closure := make(map[State]bool, len(from))
+	var stack []State
+	for state := range from {
+		closure[state] = true
+		stack = append(stack, state)
+	}
+
+	for len(stack) > 0 {
+		state := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for next := range n.epsilon[state] {
+			if !closure[next] {
+				closure[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+	return closure
+}
+
+// step advances the frontier by symbol: it computes the union of
+// transitions from every state currently in the frontier, then takes the
+// ε-closure of that union.
+func (n *NFA) step(symbol Symbol) error {
+	if !n.alphabet[symbol] {
+		return fmt.Errorf("symbol %s not in alphabet", symbol)
+	}
+
+	next := make(map[State]bool)
+	for state := range n.frontier {
+		key := TransitionKey{State: state, Symbol: symbol}
+		for dest := range n.transitions[key] {
+			next[dest] = true
+		}
+	}
+
+	n.frontier = n.epsilonClosure(next)
+	return nil
+}
+
+// ProcessString advances the NFA by each symbol of input in turn.
+func (n *NFA) ProcessString(input string) error {
+	for _, char := range input {
+		if err := n.step(Symbol(char)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsFinalState reports whether any state in the current frontier is final.
+func (n *NFA) IsFinalState() bool {
+	for state := range n.frontier {
+		if n.finalStates[state] {
+			return true
+		}
+	}
+	return false
+}
+
+// ProcessInput resets the NFA, processes input, and reports true if any
+// reachable branch ends in a final state.
+func (n *NFA) ProcessInput(input string) (bool, error) {
+	n.Reset()
+	if err := n.ProcessString(input); err != nil {
+		return false, err
+	}
+	return n.IsFinalState(), nil
+}
+
+// subsetName canonicalizes a set of NFA states into a single composite DFA
+// state name by sorting the member names and joining them.
+func subsetName(states map[State]bool) State {
+	names := make([]string, 0, len(states))
+	for state := range states {
+		names = append(names, string(state))
+	}
+	sort.Strings(names)
+	return State("{" + strings.Join(names, ",") + "}")
+}
+
+// ToDFA determinizes the NFA into an equivalent DFA via the classical
+// subset construction: composite states are sets of NFA states canonicalized
+// by their sorted member names, seeded with the ε-closure of the initial
+// state, and explored breadth-first over the alphabet until no new
+// composite states are discovered. A composite state is final iff it
+// contains at least one NFA final state.
+func (n *NFA) ToDFA() (*FSM, error) {
+	start := n.epsilonClosure(map[State]bool{n.initialState: true})
+	startName := subsetName(start)
+
+	composites := map[State]map[State]bool{startName: start}
+	queue := []State{startName}
+
+	builder := NewBuilder()
+	builder.AddStates(startName)
+	for symbol := range n.alphabet {
+		builder.AddSymbols(symbol)
+	}
+
+	var finals []State
+	transitionTargets := make(map[TransitionKey]State)
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		set := composites[name]
+
+		isFinal := false
+		for state := range set {
+			if n.finalStates[state] {
+				isFinal = true
+				break
+			}
+		}
+		if isFinal {
+			finals = append(finals, name)
+		}
+
+		for symbol := range n.alphabet {
+			moved := make(map[State]bool)
+			for state := range set {
+				key := TransitionKey{State: state, Symbol: symbol}
+				for dest := range n.transitions[key] {
+					moved[dest] = true
+				}
+			}
+			if len(moved) == 0 {
+				continue
+			}
+			closure := n.epsilonClosure(moved)
+			destName := subsetName(closure)
+
+			if _, seen := composites[destName]; !seen {
+				composites[destName] = closure
+				builder.AddStates(destName)
+				queue = append(queue, destName)
+			}
+			transitionTargets[TransitionKey{State: name, Symbol: symbol}] = destName
+		}
+	}
+
+	var err error
+	builder, err = builder.SetInitialState(startName)
+	if err != nil {
+		return nil, fmt.Errorf("error setting initial state on determinized FSM: %w", err)
+	}
+	builder, err = builder.AddFinalStates(finals...)
+	if err != nil {
+		return nil, fmt.Errorf("error adding final states to determinized FSM: %w", err)
+	}
+
+	// The subset construction only produces a partial function: composite
+	// states with no outgoing move for a symbol are simply dead ends, so
+	// route any undefined transition to an explicit trap state to keep the
+	// resulting FSM total, as Build requires.
+	trap := State("{}")
+	trapNeeded := false
+	for name := range composites {
+		for symbol := range n.alphabet {
+			if _, ok := transitionTargets[TransitionKey{State: name, Symbol: symbol}]; !ok {
+				trapNeeded = true
+				break
+			}
+		}
+		if trapNeeded {
+			break
+		}
+	}
+	if trapNeeded {
+		builder.AddStates(trap)
+		composites[trap] = map[State]bool{}
+		for symbol := range n.alphabet {
+			transitionTargets[TransitionKey{State: trap, Symbol: symbol}] = trap
+		}
+	}
+
+	for name := range composites {
+		for symbol := range n.alphabet {
+			key := TransitionKey{State: name, Symbol: symbol}
+			dest, ok := transitionTargets[key]
+			if !ok {
+				dest = trap
+			}
+			builder, err = builder.AddTransition(name, symbol, dest)
+			if err != nil {
+				return nil, fmt.Errorf("error adding transition to determinized FSM: %w", err)
+			}
+		}
+	}
+
+	dfa, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("error building determinized FSM: %w", err)
+	}
+	return dfa, nil
+}
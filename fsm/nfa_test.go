@@ -0,0 +1,144 @@
+package fsm
+
+import "testing"
+
+// buildSampleNFA builds an NFA over {a,b} accepting strings ending in "ab",
+// with two transitions on "a" from q0 (to q0 and to q1) so that
+// non-determinism is actually exercised. It has no ε-transitions.
+func buildSampleNFA(t *testing.T) *NFA {
+	t.Helper()
+
+	builder := NewNFABuilder().
+		AddStates("q0", "q1", "q2").
+		AddSymbols("a", "b")
+
+	builder, err := builder.SetInitialState("q0")
+	if err != nil {
+		t.Fatalf("SetInitialState: %v", err)
+	}
+	builder, err = builder.AddFinalStates("q2")
+	if err != nil {
+		t.Fatalf("AddFinalStates: %v", err)
+	}
+
+	builder, err = builder.AddTransition("q0", "a", "q0")
+	if err != nil {
+		t.Fatalf("AddTransition: %v", err)
+	}
+	builder, err = builder.AddTransition("q0", "b", "q0")
+	if err != nil {
+		t.Fatalf("AddTransition: %v", err)
+	}
+	builder, err = builder.AddTransition("q0", "a", "q1")
+	if err != nil {
+		t.Fatalf("AddTransition: %v", err)
+	}
+	builder, err = builder.AddTransition("q1", "b", "q2")
+	if err != nil {
+		t.Fatalf("AddTransition: %v", err)
+	}
+
+	nfa, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	return nfa
+}
+
+func TestNFAProcessInput(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"ab", true},
+		{"aab", true},
+		{"abab", true},
+		{"a", false},
+		{"ba", false},
+		{"", false},
+	}
+
+	for _, tc := range testCases {
+		nfa := buildSampleNFA(t)
+		accepted, err := nfa.ProcessInput(tc.input)
+		if err != nil {
+			t.Errorf("ProcessInput(%q) returned error: %v", tc.input, err)
+			continue
+		}
+		if accepted != tc.expected {
+			t.Errorf("ProcessInput(%q) = %v, expected %v", tc.input, accepted, tc.expected)
+		}
+	}
+}
+
+func TestNFAEpsilonClosure(t *testing.T) {
+	builder := NewNFABuilder().AddStates("q0", "q1", "q2").AddSymbols("a")
+	builder, err := builder.SetInitialState("q0")
+	if err != nil {
+		t.Fatalf("SetInitialState: %v", err)
+	}
+	builder, err = builder.AddFinalStates("q2")
+	if err != nil {
+		t.Fatalf("AddFinalStates: %v", err)
+	}
+	builder, err = builder.AddEpsilonTransition("q0", "q1")
+	if err != nil {
+		t.Fatalf("AddEpsilonTransition: %v", err)
+	}
+	builder, err = builder.AddEpsilonTransition("q1", "q2")
+	if err != nil {
+		t.Fatalf("AddEpsilonTransition: %v", err)
+	}
+
+	nfa, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	accepted, err := nfa.ProcessInput("")
+	if err != nil {
+		t.Fatalf("ProcessInput: %v", err)
+	}
+	if !accepted {
+		t.Error("expected empty input to be accepted via ε-closure of the initial state")
+	}
+}
+
+func TestNFAInvalidSymbol(t *testing.T) {
+	nfa := buildSampleNFA(t)
+	_, err := nfa.ProcessInput("c")
+	if err == nil {
+		t.Error("expected error for invalid symbol 'c', got none")
+	}
+}
+
+func TestNFAToDFA(t *testing.T) {
+	nfa := buildSampleNFA(t)
+	dfa, err := nfa.ToDFA()
+	if err != nil {
+		t.Fatalf("ToDFA: %v", err)
+	}
+
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"ab", true},
+		{"aab", true},
+		{"abab", true},
+		{"a", false},
+		{"ba", false},
+		{"", false},
+	}
+
+	for _, tc := range testCases {
+		accepted, err := dfa.ProcessInput(tc.input)
+		if err != nil {
+			t.Errorf("DFA ProcessInput(%q) returned error: %v", tc.input, err)
+			continue
+		}
+		if accepted != tc.expected {
+			t.Errorf("DFA ProcessInput(%q) = %v, expected %v", tc.input, accepted, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package fsm
+
+import (
+	"fmt"
+)
+
+// fragment is an NFA fragment under construction: a start state and an
+// accept state, with everything in between already wired into the builder.
+type fragment struct {
+	start, accept State
+}
+
+// regexCompiler holds the state needed to parse a pattern and compile it
+// into an NFA fragment via Thompson's construction.
+type regexCompiler struct {
+	builder  *NFABuilder
+	pattern  []rune
+	pos      int
+	alphabet map[Symbol]bool
+	counter  int
+}
+
+// FromRegex parses a small regular-expression dialect — concatenation, `|`,
+// `*`, `+`, `?`, `()`, character classes `[abc]`, and `.` — and compiles it
+// via Thompson's construction into an NFA, then determinizes the result to
+// a DFA using the subset construction (see NFA.ToDFA). `.` matches any
+// symbol of the alphabet, so when the pattern uses it the alphabet must be
+// supplied explicitly; otherwise alphabet may be omitted and is inferred
+// from the literal symbols appearing in pattern.
+func FromRegex(pattern string, alphabet ...Symbol) (*FSM, error) {
+	c := &regexCompiler{
+		pattern:  []rune(pattern),
+		alphabet: make(map[Symbol]bool),
+	}
+	for _, symbol := range alphabet {
+		c.alphabet[symbol] = true
+	}
+	for _, r := range pattern {
+		switch r {
+		case '|', '*', '+', '?', '(', ')', '[', ']', '.':
+			continue
+		default:
+			c.alphabet[Symbol(string(r))] = true
+		}
+	}
+	if len(c.alphabet) == 0 {
+		return nil, fmt.Errorf("FromRegex: could not infer a non-empty alphabet from pattern %q", pattern)
+	}
+
+	c.builder = NewNFABuilder()
+	for symbol := range c.alphabet {
+		c.builder.AddSymbols(symbol)
+	}
+
+	frag, err := c.parseAlternation()
+	if err != nil {
+		return nil, fmt.Errorf("FromRegex: %w", err)
+	}
+	if c.pos != len(c.pattern) {
+		return nil, fmt.Errorf("FromRegex: unexpected %q at position %d", string(c.pattern[c.pos]), c.pos)
+	}
+
+	builder, err := c.builder.SetInitialState(frag.start)
+	if err != nil {
+		return nil, fmt.Errorf("FromRegex: error setting initial state: %w", err)
+	}
+	builder, err = builder.AddFinalStates(frag.accept)
+	if err != nil {
+		return nil, fmt.Errorf("FromRegex: error adding final state: %w", err)
+	}
+
+	nfa, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("FromRegex: error building NFA: %w", err)
+	}
+
+	dfa, err := nfa.ToDFA()
+	if err != nil {
+		return nil, fmt.Errorf("FromRegex: error determinizing NFA: %w", err)
+	}
+	return dfa, nil
+}
+
+func (c *regexCompiler) newState() State {
+	c.counter++
+	state := State(fmt.Sprintf("r%d", c.counter))
+	c.builder.AddStates(state)
+	return state
+}
+
+func (c *regexCompiler) peek() (rune, bool) {
+	if c.pos >= len(c.pattern) {
+		return 0, false
+	}
+	return c.pattern[c.pos], true
+}
+
+// parseAlternation parses A | B | C.
+func (c *regexCompiler) parseAlternation() (fragment, error) {
+	left, err := c.parseConcat()
+	if err != nil {
+		return fragment{}, err
+	}
+
+	for {
+		r, ok := c.peek()
+		if !ok || r != '|' {
+			return left, nil
+		}
+		c.pos++
+
+		right, err := c.parseConcat()
+		if err != nil {
+			return fragment{}, err
+		}
+
+		start := c.newState()
+		accept := c.newState()
+		if _, err := c.builder.AddEpsilonTransition(start, left.start); err != nil {
+			return fragment{}, err
+		}
+		if _, err := c.builder.AddEpsilonTransition(start, right.start); err != nil {
+			return fragment{}, err
+		}
+		if _, err := c.builder.AddEpsilonTransition(left.accept, accept); err != nil {
+			return fragment{}, err
+		}
+		if _, err := c.builder.AddEpsilonTransition(right.accept, accept); err != nil {
+			return fragment{}, err
+		}
+		left = fragment{start: start, accept: accept}
+	}
+}
+
+// parseConcat parses AB, a sequence of one or more repeat-level fragments.
+func (c *regexCompiler) parseConcat() (fragment, error) {
+	left, err := c.parseRepeat()
+	if err != nil {
+		return fragment{}, err
+	}
+
+	for {
+		r, ok := c.peek()
+		if !ok || r == '|' || r == ')' {
+			return left, nil
+		}
+
+		right, err := c.parseRepeat()
+		if err != nil {
+			return fragment{}, err
+		}
+		if _, err := c.builder.AddEpsilonTransition(left.accept, right.start); err != nil {
+			return fragment{}, err
+		}
+		left = fragment{start: left.start, accept: right.accept}
+	}
+}
+
+// parseRepeat parses an atom followed by an optional *, + or ? postfix.
+func (c *regexCompiler) parseRepeat() (fragment, error) {
+	atom, err := c.parseAtom()
+	if err != nil {
+		return fragment{}, err
+	}
+
+	r, ok := c.peek()
+	if !ok {
+		return atom, nil
+	}
+
+	switch r {
+	case '*':
+		c.pos++
+		start := c.newState()
+		accept := c.newState()
+		if _, err := c.builder.AddEpsilonTransition(start, atom.start); err != nil {
+			return fragment{}, err
+		}
+		if _, err := c.builder.AddEpsilonTransition(start, accept); err != nil {
+			return fragment{}, err
+		}
+		if _, err := c.builder.AddEpsilonTransition(atom.accept, atom.start); err != nil {
+			return fragment{}, err
+		}
+		if _, err := c.builder.AddEpsilonTransition(atom.accept, accept); err != nil {
+			return fragment{}, err
+		}
+		return fragment{start: start, accept: accept}, nil
+	case '+':
+		c.pos++
+		start := c.newState()
+		accept := c.newState()
+		if _, err := c.builder.AddEpsilonTransition(start, atom.start); err != nil {
+			return fragment{}, err
+		}
+		if _, err := c.builder.AddEpsilonTransition(atom.accept, atom.start); err != nil {
+			return fragment{}, err
+		}
+		if _, err := c.builder.AddEpsilonTransition(atom.accept, accept); err != nil {
+			return fragment{}, err
+		}
+		return fragment{start: start, accept: accept}, nil
+	case '?':
+		c.pos++
+		start := c.newState()
+		accept := c.newState()
+		if _, err := c.builder.AddEpsilonTransition(start, atom.start); err != nil {
+			return fragment{}, err
+		}
+		if _, err := c.builder.AddEpsilonTransition(start, accept); err != nil {
+			return fragment{}, err
+		}
+		if _, err := c.builder.AddEpsilonTransition(atom.accept, accept); err != nil {
+			return fragment{}, err
+		}
+		return fragment{start: start, accept: accept}, nil
+	default:
+		return atom, nil
+	}
+}
+
+// parseAtom parses a literal symbol, `.`, a character class, or a
+// parenthesized sub-expression.
+func (c *regexCompiler) parseAtom() (fragment, error) {
+	r, ok := c.peek()
+	if !ok {
+		return fragment{}, fmt.Errorf("unexpected end of pattern")
+	}
+
+	switch r {
+	case '(':
+		c.pos++
+		frag, err := c.parseAlternation()
+		if err != nil {
+			return fragment{}, err
+		}
+		closing, ok := c.peek()
+		if !ok || closing != ')' {
+			return fragment{}, fmt.Errorf("missing closing ')'")
+		}
+		c.pos++
+		return frag, nil
+	case '[':
+		c.pos++
+		var symbols []Symbol
+		for {
+			r, ok := c.peek()
+			if !ok {
+				return fragment{}, fmt.Errorf("missing closing ']'")
+			}
+			if r == ']' {
+				c.pos++
+				break
+			}
+			symbols = append(symbols, Symbol(string(r)))
+			c.pos++
+		}
+		if len(symbols) == 0 {
+			return fragment{}, fmt.Errorf("empty character class '[]'")
+		}
+		return c.literalSet(symbols)
+	case '.':
+		c.pos++
+		symbols := make([]Symbol, 0, len(c.alphabet))
+		for symbol := range c.alphabet {
+			symbols = append(symbols, symbol)
+		}
+		return c.literalSet(symbols)
+	default:
+		c.pos++
+		return c.literalSet([]Symbol{Symbol(string(r))})
+	}
+}
+
+// literalSet builds a fragment that matches any one of the given symbols.
+func (c *regexCompiler) literalSet(symbols []Symbol) (fragment, error) {
+	start := c.newState()
+	accept := c.newState()
+	for _, symbol := range symbols {
+		if !c.alphabet[symbol] {
+			return fragment{}, fmt.Errorf("symbol %q not in alphabet", symbol)
+		}
+		if _, err := c.builder.AddTransition(start, symbol, accept); err != nil {
+			return fragment{}, err
+		}
+	}
+	return fragment{start: start, accept: accept}, nil
+}
@@ -0,0 +1,122 @@
+package fsm
+
+import "testing"
+
+func TestFromRegexLiteralAndConcat(t *testing.T) {
+	machine, err := FromRegex("ab")
+	if err != nil {
+		t.Fatalf("FromRegex: %v", err)
+	}
+
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"ab", true},
+		{"a", false},
+		{"aba", false},
+	}
+	for _, tc := range testCases {
+		accepted, err := machine.ProcessInput(tc.input)
+		if err != nil {
+			t.Errorf("ProcessInput(%q) returned error: %v", tc.input, err)
+			continue
+		}
+		if accepted != tc.expected {
+			t.Errorf("ProcessInput(%q) = %v, expected %v", tc.input, accepted, tc.expected)
+		}
+	}
+}
+
+func TestFromRegexAlternationAndStar(t *testing.T) {
+	machine, err := FromRegex("(a|b)*c")
+	if err != nil {
+		t.Fatalf("FromRegex: %v", err)
+	}
+
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"c", true},
+		{"ac", true},
+		{"abababc", true},
+		{"ab", false},
+		{"", false},
+	}
+	for _, tc := range testCases {
+		accepted, err := machine.ProcessInput(tc.input)
+		if err != nil {
+			t.Errorf("ProcessInput(%q) returned error: %v", tc.input, err)
+			continue
+		}
+		if accepted != tc.expected {
+			t.Errorf("ProcessInput(%q) = %v, expected %v", tc.input, accepted, tc.expected)
+		}
+	}
+}
+
+func TestFromRegexPlusAndOptional(t *testing.T) {
+	machine, err := FromRegex("ab+c?")
+	if err != nil {
+		t.Fatalf("FromRegex: %v", err)
+	}
+
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"ab", true},
+		{"abbb", true},
+		{"abc", true},
+		{"abbc", true},
+		{"a", false},
+		{"ac", false},
+	}
+	for _, tc := range testCases {
+		accepted, err := machine.ProcessInput(tc.input)
+		if err != nil {
+			t.Errorf("ProcessInput(%q) returned error: %v", tc.input, err)
+			continue
+		}
+		if accepted != tc.expected {
+			t.Errorf("ProcessInput(%q) = %v, expected %v", tc.input, accepted, tc.expected)
+		}
+	}
+}
+
+func TestFromRegexCharacterClassAndDot(t *testing.T) {
+	machine, err := FromRegex("[abc].", "a", "b", "c", "d")
+	if err != nil {
+		t.Fatalf("FromRegex: %v", err)
+	}
+
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"ad", true},
+		{"bb", true},
+		{"dd", false},
+		{"a", false},
+	}
+	for _, tc := range testCases {
+		accepted, err := machine.ProcessInput(tc.input)
+		if err != nil {
+			t.Errorf("ProcessInput(%q) returned error: %v", tc.input, err)
+			continue
+		}
+		if accepted != tc.expected {
+			t.Errorf("ProcessInput(%q) = %v, expected %v", tc.input, accepted, tc.expected)
+		}
+	}
+}
+
+func TestFromRegexInvalidPattern(t *testing.T) {
+	if _, err := FromRegex("(a"); err == nil {
+		t.Error("expected error for unbalanced '(', got none")
+	}
+	if _, err := FromRegex("[ab"); err == nil {
+		t.Error("expected error for unbalanced '[', got none")
+	}
+}
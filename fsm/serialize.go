@@ -0,0 +1,206 @@
+package fsm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// transitionTriple is a (from, symbol, to) entry in the canonical
+// serialization schema. It marshals as a 3-element [from, symbol, to]
+// array rather than an object, matching the schema external tools consume.
+type transitionTriple struct {
+	From   State
+	Symbol Symbol
+	To     State
+}
+
+// MarshalJSON encodes the triple as ["from", "symbol", "to"].
+func (t transitionTriple) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3]string{string(t.From), string(t.Symbol), string(t.To)})
+}
+
+// UnmarshalJSON decodes a ["from", "symbol", "to"] array into the triple.
+func (t *transitionTriple) UnmarshalJSON(data []byte) error {
+	var triple [3]string
+	if err := json.Unmarshal(data, &triple); err != nil {
+		return fmt.Errorf("error unmarshaling transition triple: %w", err)
+	}
+	t.From, t.Symbol, t.To = State(triple[0]), Symbol(triple[1]), State(triple[2])
+	return nil
+}
+
+// MarshalYAML encodes the triple as a [from, symbol, to] sequence.
+func (t transitionTriple) MarshalYAML() (any, error) {
+	return [3]string{string(t.From), string(t.Symbol), string(t.To)}, nil
+}
+
+// UnmarshalYAML decodes a [from, symbol, to] sequence into the triple.
+func (t *transitionTriple) UnmarshalYAML(value *yaml.Node) error {
+	var triple [3]string
+	if err := value.Decode(&triple); err != nil {
+		return fmt.Errorf("error unmarshaling transition triple: %w", err)
+	}
+	t.From, t.Symbol, t.To = State(triple[0]), Symbol(triple[1]), State(triple[2])
+	return nil
+}
+
+// fsmSchema is the canonical, deterministic representation of an FSM used
+// by both the JSON and YAML codecs: states, alphabet and final states are
+// sorted, and transitions are sorted by (from, symbol), so that two
+// serializations of the same FSM compare equal byte-for-byte. State names
+// are kept as they are, so FSMs that are merely language-equivalent
+// generally serialize differently.
+type fsmSchema struct {
+	States      []State            `json:"states" yaml:"states"`
+	Alphabet    []Symbol           `json:"alphabet" yaml:"alphabet"`
+	Initial     State              `json:"initial" yaml:"initial"`
+	Finals      []State            `json:"finals" yaml:"finals"`
+	Transitions []transitionTriple `json:"transitions" yaml:"transitions"`
+}
+
+// toSchema converts f into its canonical, sorted schema representation.
+func (f *FSM) toSchema() fsmSchema {
+	schema := fsmSchema{
+		Initial: f.initialState,
+	}
+
+	for state := range f.states {
+		schema.States = append(schema.States, state)
+	}
+	sort.Slice(schema.States, func(i, j int) bool { return schema.States[i] < schema.States[j] })
+
+	for symbol := range f.alphabet {
+		schema.Alphabet = append(schema.Alphabet, symbol)
+	}
+	sort.Slice(schema.Alphabet, func(i, j int) bool { return schema.Alphabet[i] < schema.Alphabet[j] })
+
+	for state := range f.finalStates {
+		schema.Finals = append(schema.Finals, state)
+	}
+	sort.Slice(schema.Finals, func(i, j int) bool { return schema.Finals[i] < schema.Finals[j] })
+
+	for key, dest := range f.transitions {
+		schema.Transitions = append(schema.Transitions, transitionTriple{From: key.State, Symbol: key.Symbol, To: dest})
+	}
+	sort.Slice(schema.Transitions, func(i, j int) bool {
+		a, b := schema.Transitions[i], schema.Transitions[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		return a.Symbol < b.Symbol
+	})
+
+	return schema
+}
+
+// fromSchema rebuilds an FSM from its canonical schema representation using
+// the ordinary Builder, so the result is validated exactly as a
+// hand-assembled FSM would be.
+func fromSchema(schema fsmSchema) (*FSM, error) {
+	builder := NewBuilder().AddStates(schema.States...).AddSymbols(schema.Alphabet...)
+
+	builder, err := builder.SetInitialState(schema.Initial)
+	if err != nil {
+		return nil, fmt.Errorf("error setting initial state: %w", err)
+	}
+	builder, err = builder.AddFinalStates(schema.Finals...)
+	if err != nil {
+		return nil, fmt.Errorf("error adding final states: %w", err)
+	}
+	for _, tr := range schema.Transitions {
+		builder, err = builder.AddTransition(tr.From, tr.Symbol, tr.To)
+		if err != nil {
+			return nil, fmt.Errorf("error adding transition: %w", err)
+		}
+	}
+
+	machine, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("error building FSM: %w", err)
+	}
+	return machine, nil
+}
+
+// MarshalJSON serializes f using the canonical schema: a states array, an
+// alphabet array, the initial state, a finals array, and transitions as
+// sorted [from, symbol, to] triples, so that round-tripping through
+// external tools is deterministic.
+func (f *FSM) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.toSchema())
+}
+
+// UnmarshalFSM parses data in the canonical JSON schema (see MarshalJSON)
+// and rebuilds the FSM it describes.
+func UnmarshalFSM(data []byte) (*FSM, error) {
+	var schema fsmSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("error unmarshaling FSM: %w", err)
+	}
+	return fromSchema(schema)
+}
+
+// ToYAML serializes f using the same canonical schema as MarshalJSON.
+func (f *FSM) ToYAML() ([]byte, error) {
+	return yaml.Marshal(f.toSchema())
+}
+
+// FromYAML parses data in the canonical YAML schema (see ToYAML) and
+// rebuilds the FSM it describes.
+func FromYAML(data []byte) (*FSM, error) {
+	var schema fsmSchema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("error unmarshaling FSM: %w", err)
+	}
+	return fromSchema(schema)
+}
+
+// ToDOT writes f as a Graphviz DOT graph to w: final states are drawn as
+// double circles, and an arrow from a point-shaped "start" node marks the
+// initial state.
+func (f *FSM) ToDOT(w io.Writer) error {
+	schema := f.toSchema()
+
+	if _, err := fmt.Fprintln(w, "digraph FSM {"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "\trankdir=LR;"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "\tstart [shape=point];"); err != nil {
+		return err
+	}
+
+	finals := make(map[State]bool, len(schema.Finals))
+	for _, state := range schema.Finals {
+		finals[state] = true
+	}
+
+	for _, state := range schema.States {
+		shape := "circle"
+		if finals[state] {
+			shape = "doublecircle"
+		}
+		if _, err := fmt.Fprintf(w, "\t%q [shape=%s];\n", state, shape); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(w, "\tstart -> %q;\n", schema.Initial); err != nil {
+		return err
+	}
+
+	for _, tr := range schema.Transitions {
+		if _, err := fmt.Fprintf(w, "\t%q -> %q [label=%q];\n", tr.From, tr.To, tr.Symbol); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintln(w, "}"); err != nil {
+		return err
+	}
+	return nil
+}
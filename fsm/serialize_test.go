@@ -0,0 +1,148 @@
+package fsm
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestMarshalUnmarshalJSONRoundTrip(t *testing.T) {
+	original, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	data, err := original.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	restored, err := UnmarshalFSM(data)
+	if err != nil {
+		t.Fatalf("UnmarshalFSM: %v", err)
+	}
+
+	for _, input := range []string{"", "0", "1", "10", "1101", "111000"} {
+		want, err := original.ProcessInput(input)
+		if err != nil {
+			t.Fatalf("ProcessInput(%q) on original: %v", input, err)
+		}
+		got, err := restored.ProcessInput(input)
+		if err != nil {
+			t.Fatalf("ProcessInput(%q) on restored: %v", input, err)
+		}
+		if got != want {
+			t.Errorf("restored.ProcessInput(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestMarshalJSONDeterministic(t *testing.T) {
+	machine, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	first, err := machine.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	second, err := machine.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if string(first) != string(second) {
+		t.Errorf("expected repeated MarshalJSON calls to produce identical output, got:\n%s\nvs\n%s", first, second)
+	}
+}
+
+func TestToYAMLFromYAMLRoundTrip(t *testing.T) {
+	original, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	data, err := original.ToYAML()
+	if err != nil {
+		t.Fatalf("ToYAML: %v", err)
+	}
+
+	restored, err := FromYAML(data)
+	if err != nil {
+		t.Fatalf("FromYAML: %v", err)
+	}
+
+	for _, input := range []string{"", "0", "1", "10", "1101"} {
+		want, err := original.ProcessInput(input)
+		if err != nil {
+			t.Fatalf("ProcessInput(%q) on original: %v", input, err)
+		}
+		got, err := restored.ProcessInput(input)
+		if err != nil {
+			t.Fatalf("ProcessInput(%q) on restored: %v", input, err)
+		}
+		if got != want {
+			t.Errorf("restored.ProcessInput(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestMarshalJSONTransitionsAreTriples(t *testing.T) {
+	machine, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	data, err := machine.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	var raw struct {
+		Transitions []json.RawMessage `json:"transitions"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if len(raw.Transitions) == 0 {
+		t.Fatal("expected at least one transition")
+	}
+	for _, tr := range raw.Transitions {
+		var triple [3]string
+		if err := json.Unmarshal(tr, &triple); err != nil {
+			t.Errorf("expected transition %s to decode as a 3-element array, got error: %v", tr, err)
+		}
+	}
+}
+
+func TestUnmarshalFSMInvalidJSON(t *testing.T) {
+	if _, err := UnmarshalFSM([]byte("not json")); err == nil {
+		t.Error("expected error for invalid JSON, got none")
+	}
+}
+
+func TestToDOT(t *testing.T) {
+	machine, err := NewModThreeFSM()
+	if err != nil {
+		t.Fatalf("NewModThreeFSM: %v", err)
+	}
+
+	var buf strings.Builder
+	if err := machine.ToDOT(&buf); err != nil {
+		t.Fatalf("ToDOT: %v", err)
+	}
+	dot := buf.String()
+
+	if !strings.HasPrefix(dot, "digraph FSM {") {
+		t.Errorf("expected DOT output to start with 'digraph FSM {', got:\n%s", dot)
+	}
+	if !strings.Contains(dot, `"s0" [shape=doublecircle];`) {
+		t.Errorf("expected final state s0 to be drawn as a double circle, got:\n%s", dot)
+	}
+	if !strings.Contains(dot, `start -> "s0";`) {
+		t.Errorf("expected an arrow from start into the initial state s0, got:\n%s", dot)
+	}
+	if !strings.Contains(dot, `"s0" -> "s1" [label="1"];`) {
+		t.Errorf("expected transition s0 -> s1 on '1' in DOT output, got:\n%s", dot)
+	}
+}
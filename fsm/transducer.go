@@ -0,0 +1,201 @@
+package fsm
+
+import "fmt"
+
+// Transducer represents a finite-state transducer: a DFA-shaped transition
+// function over (Q, Σ, q0, δ) where transitions and/or states additionally
+// carry output symbols from an output alphabet Δ. Mealy-style transitions
+// (AddMealyTransition) emit output on a (state, symbol) move; Moore-style
+// outputs (SetMooreOutput) emit output on entering a state. Both may be
+// used together, which lets a caller like the mod-3 example emit the
+// running remainder as output rather than switching on CurrentState.
+type Transducer struct {
+	states         map[State]bool
+	alphabet       map[Symbol]bool
+	outputAlphabet map[Symbol]bool
+	initialState   State
+	transitions    map[TransitionKey]State  // δ: transition function Q×Σ→Q
+	mealyOutputs   map[TransitionKey]Symbol // output emitted on a (state, symbol) transition
+	mooreOutputs   map[State]Symbol         // output emitted on entering a state
+	currentState   State
+}
+
+// TransducerBuilder provides a fluent interface for building Transducers,
+// mirroring Builder.
+type TransducerBuilder struct {
+	transducer *Transducer
+}
+
+// NewTransducerBuilder creates a new Transducer builder.
+func NewTransducerBuilder() *TransducerBuilder {
+	return &TransducerBuilder{
+		transducer: &Transducer{
+			states:         make(map[State]bool),
+			alphabet:       make(map[Symbol]bool),
+			outputAlphabet: make(map[Symbol]bool),
+			transitions:    make(map[TransitionKey]State),
+			mealyOutputs:   make(map[TransitionKey]Symbol),
+			mooreOutputs:   make(map[State]Symbol),
+		},
+	}
+}
+
+// AddStates adds multiple states to the Transducer.
+func (b *TransducerBuilder) AddStates(states ...State) *TransducerBuilder {
+	for _, state := range states {
+		b.transducer.states[state] = true
+	}
+	return b
+}
+
+// AddSymbols adds multiple symbols to the Transducer's input alphabet.
+func (b *TransducerBuilder) AddSymbols(symbols ...Symbol) *TransducerBuilder {
+	for _, symbol := range symbols {
+		b.transducer.alphabet[symbol] = true
+	}
+	return b
+}
+
+// AddOutputSymbols adds multiple symbols to the Transducer's output
+// alphabet Δ.
+func (b *TransducerBuilder) AddOutputSymbols(symbols ...Symbol) *TransducerBuilder {
+	for _, symbol := range symbols {
+		b.transducer.outputAlphabet[symbol] = true
+	}
+	return b
+}
+
+// SetInitialState designates the Transducer's initial state.
+func (b *TransducerBuilder) SetInitialState(state State) (*TransducerBuilder, error) {
+	if !b.transducer.states[state] {
+		return b, fmt.Errorf("state %s not in state set", state)
+	}
+	b.transducer.initialState = state
+	return b, nil
+}
+
+// AddMealyTransition records δ(state, in) = next and that the transition
+// emits out. An empty out records the transition with no Mealy output, so
+// the Moore output of next, if any, is emitted instead.
+func (b *TransducerBuilder) AddMealyTransition(state State, in Symbol, next State, out Symbol) (*TransducerBuilder, error) {
+	if !b.transducer.states[state] {
+		return b, fmt.Errorf("state %s not in state set", state)
+	}
+	if !b.transducer.states[next] {
+		return b, fmt.Errorf("next state %s not in state set", next)
+	}
+	if !b.transducer.alphabet[in] {
+		return b, fmt.Errorf("symbol %s not in alphabet", in)
+	}
+	if out != "" && !b.transducer.outputAlphabet[out] {
+		return b, fmt.Errorf("output symbol %s not in output alphabet", out)
+	}
+
+	key := TransitionKey{State: state, Symbol: in}
+	if _, exists := b.transducer.transitions[key]; exists {
+		return b, fmt.Errorf("transition δ(%s, %s) already defined", state, in)
+	}
+
+	b.transducer.transitions[key] = next
+	if out != "" {
+		b.transducer.mealyOutputs[key] = out
+	}
+	return b, nil
+}
+
+// SetMooreOutput records that entering state emits out.
+func (b *TransducerBuilder) SetMooreOutput(state State, out Symbol) (*TransducerBuilder, error) {
+	if !b.transducer.states[state] {
+		return b, fmt.Errorf("state %s not in state set", state)
+	}
+	if !b.transducer.outputAlphabet[out] {
+		return b, fmt.Errorf("output symbol %s not in output alphabet", out)
+	}
+
+	b.transducer.mooreOutputs[state] = out
+	return b, nil
+}
+
+// Build validates the Transducer and returns it. The output alphabet is
+// validated analogously to Σ: it must be non-empty, and every output
+// symbol used by AddMealyTransition/SetMooreOutput is checked against it as
+// it is added. The transition function must be total, as with the DFA
+// Builder.
+func (b *TransducerBuilder) Build() (*Transducer, error) {
+	if len(b.transducer.states) == 0 {
+		return nil, fmt.Errorf("Transducer must have at least one state")
+	}
+	if len(b.transducer.alphabet) == 0 {
+		return nil, fmt.Errorf("Transducer must have at least one symbol in alphabet")
+	}
+	if len(b.transducer.outputAlphabet) == 0 {
+		return nil, fmt.Errorf("Transducer must have at least one symbol in output alphabet")
+	}
+	if b.transducer.initialState == "" {
+		return nil, fmt.Errorf("Transducer must have an initial state")
+	}
+	if !b.transducer.states[b.transducer.initialState] {
+		return nil, fmt.Errorf("initial state must be in state set")
+	}
+
+	for state := range b.transducer.states {
+		for symbol := range b.transducer.alphabet {
+			key := TransitionKey{State: state, Symbol: symbol}
+			if _, exists := b.transducer.transitions[key]; !exists {
+				return nil, fmt.Errorf("transition δ(%s, %s) is not defined", state, symbol)
+			}
+		}
+	}
+
+	b.transducer.currentState = b.transducer.initialState
+	return b.transducer, nil
+}
+
+// Reset returns the Transducer to its initial state.
+func (t *Transducer) Reset() {
+	t.currentState = t.initialState
+}
+
+// CurrentState returns the Transducer's current state.
+func (t *Transducer) CurrentState() State {
+	return t.currentState
+}
+
+// step advances the Transducer by symbol and returns whatever output the
+// move produces: the Mealy output registered for this (state, symbol)
+// transition if any, otherwise the Moore output registered for the
+// destination state if any, otherwise the empty string.
+func (t *Transducer) step(symbol Symbol) (Symbol, error) {
+	if !t.alphabet[symbol] {
+		return "", fmt.Errorf("symbol %s not in alphabet", symbol)
+	}
+
+	key := TransitionKey{State: t.currentState, Symbol: symbol}
+	nextState, exists := t.transitions[key]
+	if !exists {
+		return "", fmt.Errorf("no transition defined for δ(%s, %s)", t.currentState, symbol)
+	}
+
+	out, hasMealy := t.mealyOutputs[key]
+	t.currentState = nextState
+	if hasMealy {
+		return out, nil
+	}
+	return t.mooreOutputs[nextState], nil
+}
+
+// Translate resets the Transducer, then streams the concatenated outputs
+// produced while processing input.
+func (t *Transducer) Translate(input string) (string, error) {
+	t.Reset()
+
+	var output string
+	for _, char := range input {
+		out, err := t.step(Symbol(char))
+		if err != nil {
+			return "", err
+		}
+		output += string(out)
+	}
+	return output, nil
+}
@@ -0,0 +1,141 @@
+package fsm
+
+import "testing"
+
+// buildModThreeTransducer mirrors NewModThreeFSM but emits the running
+// remainder as output instead of requiring callers to switch on
+// CurrentState: each state's remainder is set as its Moore output and is
+// also passed as the Mealy output of every transition into that state.
+func buildModThreeTransducer(t *testing.T) *Transducer {
+	t.Helper()
+
+	builder := NewTransducerBuilder().
+		AddStates("s0", "s1", "s2").
+		AddSymbols("0", "1").
+		AddOutputSymbols("0", "1", "2")
+
+	builder, err := builder.SetInitialState("s0")
+	if err != nil {
+		t.Fatalf("SetInitialState: %v", err)
+	}
+
+	builder, err = builder.SetMooreOutput("s0", "0")
+	if err != nil {
+		t.Fatalf("SetMooreOutput: %v", err)
+	}
+	builder, err = builder.SetMooreOutput("s1", "1")
+	if err != nil {
+		t.Fatalf("SetMooreOutput: %v", err)
+	}
+	builder, err = builder.SetMooreOutput("s2", "2")
+	if err != nil {
+		t.Fatalf("SetMooreOutput: %v", err)
+	}
+
+	transitions := []struct {
+		state State
+		in    Symbol
+		next  State
+	}{
+		{"s0", "0", "s0"},
+		{"s0", "1", "s1"},
+		{"s1", "0", "s2"},
+		{"s1", "1", "s0"},
+		{"s2", "0", "s1"},
+		{"s2", "1", "s2"},
+	}
+	for _, tr := range transitions {
+		builder, err = builder.AddMealyTransition(tr.state, tr.in, tr.next, builder.transducer.mooreOutputs[tr.next])
+		if err != nil {
+			t.Fatalf("AddMealyTransition: %v", err)
+		}
+	}
+
+	transducer, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	return transducer
+}
+
+func TestTransducerTranslate(t *testing.T) {
+	testCases := []struct {
+		binary   string
+		expected string
+	}{
+		{"0", "0"},
+		{"1", "1"},
+		{"10", "12"},
+		{"1011", "1222"},
+	}
+
+	for _, tc := range testCases {
+		transducer := buildModThreeTransducer(t)
+		output, err := transducer.Translate(tc.binary)
+		if err != nil {
+			t.Errorf("Translate(%q) returned error: %v", tc.binary, err)
+			continue
+		}
+		if output != tc.expected {
+			t.Errorf("Translate(%q) = %q, expected %q", tc.binary, output, tc.expected)
+		}
+	}
+}
+
+func TestTransducerInvalidSymbol(t *testing.T) {
+	transducer := buildModThreeTransducer(t)
+	_, err := transducer.Translate("2")
+	if err == nil {
+		t.Error("expected error for invalid symbol '2', got none")
+	}
+}
+
+func TestTransducerBuildRequiresOutputAlphabet(t *testing.T) {
+	builder := NewTransducerBuilder().AddStates("s0").AddSymbols("0")
+	builder, err := builder.SetInitialState("s0")
+	if err != nil {
+		t.Fatalf("SetInitialState: %v", err)
+	}
+
+	_, err = builder.Build()
+	if err == nil || err.Error() != "Transducer must have at least one symbol in output alphabet" {
+		t.Errorf("expected output alphabet error, got %v", err)
+	}
+}
+
+func TestTransducerMealyOutputOverridesMoore(t *testing.T) {
+	builder := NewTransducerBuilder().
+		AddStates("s0", "s1").
+		AddSymbols("a").
+		AddOutputSymbols("x", "y")
+
+	builder, err := builder.SetInitialState("s0")
+	if err != nil {
+		t.Fatalf("SetInitialState: %v", err)
+	}
+	builder, err = builder.SetMooreOutput("s1", "y")
+	if err != nil {
+		t.Fatalf("SetMooreOutput: %v", err)
+	}
+	builder, err = builder.AddMealyTransition("s0", "a", "s1", "x")
+	if err != nil {
+		t.Fatalf("AddMealyTransition: %v", err)
+	}
+	builder, err = builder.AddMealyTransition("s1", "a", "s0", "x")
+	if err != nil {
+		t.Fatalf("AddMealyTransition: %v", err)
+	}
+
+	transducer, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	output, err := transducer.Translate("a")
+	if err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	if output != "x" {
+		t.Errorf("Translate(%q) = %q, expected Mealy output %q to take precedence over Moore output", "a", output, "x")
+	}
+}